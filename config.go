@@ -0,0 +1,154 @@
+package slogtool
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"runtime/debug"
+	"time"
+
+	"github.com/lmittmann/tint"
+)
+
+// Config configures a Slogger. Unlike InitLogger, level, format and output
+// are independent: any Format can be combined with any Level, so e.g.
+// production JSON at Debug level or colored tint at Error level both work.
+type Config struct {
+	// Level is the minimum severity that will be logged.
+	Level LogLevel
+	// Format selects the handler used to render records. Defaults to FormatJSON.
+	Format Format
+	// Output is "stdout", "stderr", a file path, or an io.Writer such as one
+	// returned by RotatingFile. Defaults to "stdout".
+	Output any
+	// AddSource enables slog.HandlerOptions.AddSource so records carry the
+	// caller's file:line. Ignored when Mode is set.
+	AddSource bool
+	// Mode, if set, overrides AddSource with ConvertAddSource(Mode): true in
+	// DevMode, false in ProdMode. Leave unset to control AddSource directly.
+	Mode Mode
+	// TimeFormat is the timestamp layout used by FormatTint. Ignored by
+	// FormatText and FormatJSON. Defaults to time.Kitchen.
+	TimeFormat string
+	// DisableTime omits the timestamp attribute from every record.
+	DisableTime bool
+	// ReplaceAttr is forwarded to the handler options of every Format:
+	// slog.HandlerOptions for FormatText and FormatJSON, tint.Options for FormatTint.
+	ReplaceAttr func(groups []string, a slog.Attr) slog.Attr
+}
+
+// NewFromConfig builds a Slogger from cfg. Unlike InitLogger, it returns an
+// error instead of panicking when the configured output file cannot be opened.
+func NewFromConfig(cfg Config) (*Slogger, error) {
+	writer, err := resolveOutput(cfg.Output)
+	if err != nil {
+		return nil, fmt.Errorf("resolve log output: %w", err)
+	}
+
+	buildInfo, _ := debug.ReadBuildInfo()
+	if buildInfo == nil {
+		buildInfo = &debug.BuildInfo{GoVersion: "unknown"}
+	}
+
+	if cfg.Mode != "" {
+		cfg.AddSource = ConvertAddSource(cfg.Mode)
+	}
+
+	levelVar := &slog.LevelVar{}
+	levelVar.Set(toSlogLevel(cfg.Level))
+
+	return &Slogger{
+		logger: slog.New(buildHandler(writer, cfg, levelVar)).With(
+			slog.Group("program_info",
+				slog.String("go_version", buildInfo.GoVersion),
+			),
+		),
+		level: levelVar,
+	}, nil
+}
+
+// resolveOutput turns a Config.Output value into the io.Writer it names.
+// Output may be "stdout", "stderr", a file path string, or an io.Writer
+// (e.g. the result of RotatingFile), which is used as-is.
+func resolveOutput(output any) (io.Writer, error) {
+	switch o := output.(type) {
+	case io.Writer:
+		return o, nil
+	case string:
+		switch o {
+		case "", "stdout":
+			return os.Stdout, nil
+		case "stderr":
+			return os.Stderr, nil
+		default:
+			file, err := os.OpenFile(o, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0755)
+			if err != nil {
+				return nil, fmt.Errorf("error opening log file %s: %w", o, err)
+			}
+
+			return file, nil
+		}
+	case nil:
+		return os.Stdout, nil
+	default:
+		return nil, fmt.Errorf("unsupported log output type %T", output)
+	}
+}
+
+// buildHandler builds the slog.Handler named by cfg.Format. level is
+// consulted on every record, so passing a *slog.LevelVar lets the threshold
+// change after the handler is built.
+func buildHandler(w io.Writer, cfg Config, level slog.Leveler) slog.Handler {
+	var replaceAttr func(groups []string, a slog.Attr) slog.Attr
+	if cfg.DisableTime {
+		replaceAttr = dropTimeAttr(cfg.ReplaceAttr)
+	} else {
+		replaceAttr = cfg.ReplaceAttr
+	}
+
+	switch cfg.Format {
+	case FormatText:
+		return slog.NewTextHandler(w, &slog.HandlerOptions{
+			Level:       level,
+			AddSource:   cfg.AddSource,
+			ReplaceAttr: replaceAttr,
+		})
+	case FormatTint:
+		timeFormat := cfg.TimeFormat
+		if timeFormat == "" {
+			timeFormat = time.Kitchen
+		}
+
+		return tint.NewHandler(w, &tint.Options{
+			Level:       level,
+			TimeFormat:  timeFormat,
+			AddSource:   cfg.AddSource,
+			ReplaceAttr: replaceAttr,
+		})
+	case FormatJSON:
+		fallthrough
+	default:
+		return slog.NewJSONHandler(w, &slog.HandlerOptions{
+			Level:       level,
+			AddSource:   cfg.AddSource,
+			ReplaceAttr: replaceAttr,
+		})
+	}
+}
+
+// dropTimeAttr wraps next so the slog.TimeKey attribute is removed before
+// next (if any) sees the remaining attributes.
+func dropTimeAttr(next func(groups []string, a slog.Attr) slog.Attr) func(groups []string, a slog.Attr) slog.Attr {
+	return func(groups []string, a slog.Attr) slog.Attr {
+		if len(groups) == 0 && a.Key == slog.TimeKey {
+			return slog.Attr{}
+		}
+
+		if next != nil {
+			return next(groups, a)
+		}
+
+		return a
+	}
+}
@@ -0,0 +1,25 @@
+package slogtool
+
+import "context"
+
+// loggerCtxKey is the unexported key type used to store a Logger in a context.Context.
+type loggerCtxKey struct{}
+
+// NewContext returns a new context.Context derived from ctx that carries the
+// given Logger. Downstream code can retrieve it with FromContext instead of
+// having the logger threaded through every function signature.
+func NewContext(ctx context.Context, logger Logger) context.Context {
+	return context.WithValue(ctx, loggerCtxKey{}, logger)
+}
+
+// FromContext returns the Logger stored in ctx by NewContext. If ctx carries
+// no logger, it returns noopLogger, a Logger that discards everything it is
+// given, so callers can always use the result without a nil check.
+func FromContext(ctx context.Context) Logger {
+	logger, ok := ctx.Value(loggerCtxKey{}).(Logger)
+	if !ok {
+		return noop
+	}
+
+	return logger
+}
@@ -32,3 +32,11 @@ func ConvertLoggerMode(mode Mode) LogLevel {
 		return LevelInfo
 	}
 }
+
+// ConvertAddSource returns the default Config.AddSource for mode: true in
+// DevMode, so records carry the caller's file:line during development, and
+// false in ProdMode, where the extra record size and the cost of resolving
+// the caller's frame aren't worth paying by default.
+func ConvertAddSource(mode Mode) bool {
+	return mode == DevMode
+}
@@ -0,0 +1,114 @@
+package slogtool
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// stackTracer is implemented by errors that carry a stack trace, such as
+// those produced by github.com/pkg/errors.
+type stackTracer interface {
+	StackTrace() pkgerrors.StackTrace
+}
+
+// stackAttr returns the "stack" group attribute for the first stack trace
+// found by walking err and its wrapped errors, if any has one. This is what
+// lets a stack trace survive the common `fmt.Errorf("doing X: %w", pkgErr)`
+// idiom, where pkgErr (not err itself) is the one that carries it.
+func stackAttr(err error) (slog.Attr, bool) {
+	tracer, ok := findStackTracer(err)
+	if !ok {
+		return slog.Attr{}, false
+	}
+
+	trace := tracer.StackTrace()
+	frames := make([]string, len(trace))
+
+	for i, frame := range trace {
+		frames[i] = strings.ReplaceAll(fmt.Sprintf("%+v", frame), "\n\t", " ")
+	}
+
+	return slog.Group("stack", slog.Any("frames", frames)), true
+}
+
+// findStackTracer walks err, then its wrapped errors (following both
+// single-error Unwrap() error and multi-error Unwrap() []error), returning
+// the first one that implements stackTracer.
+func findStackTracer(err error) (stackTracer, bool) {
+	for cur := err; cur != nil; {
+		if tracer, ok := cur.(stackTracer); ok {
+			return tracer, true
+		}
+
+		if joined, ok := cur.(interface{ Unwrap() []error }); ok {
+			for _, e := range joined.Unwrap() {
+				if tracer, ok := findStackTracer(e); ok {
+					return tracer, true
+				}
+			}
+
+			return nil, false
+		}
+
+		cur = errors.Unwrap(cur)
+	}
+
+	return nil, false
+}
+
+// errorChainAttr returns the "error_chain" attribute listing the wrapped
+// errors that unwrapChain finds under err, if it finds more than one.
+func errorChainAttr(err error) (slog.Attr, bool) {
+	chain := unwrapChain(err)
+	if len(chain) < 2 {
+		return slog.Attr{}, false
+	}
+
+	return slog.Any("error_chain", chain), true
+}
+
+// unwrapChain walks err's wrapped errors, following both single-error
+// Unwrap() error (as produced by fmt.Errorf("%w", ...)) and multi-error
+// Unwrap() []error (as produced by errors.Join). At a multi-error the walk
+// lists its members and stops; errors wrapped by those members are not listed.
+func unwrapChain(err error) []string {
+	var chain []string
+
+	for cur := err; cur != nil; {
+		if joined, ok := cur.(interface{ Unwrap() []error }); ok {
+			for _, e := range joined.Unwrap() {
+				chain = append(chain, e.Error())
+			}
+
+			break
+		}
+
+		next := errors.Unwrap(cur)
+		if next == nil {
+			break
+		}
+
+		chain = append(chain, next.Error())
+		cur = next
+	}
+
+	return chain
+}
+
+// errAttrs appends the structured stack and error_chain attributes to attrs
+// for err, in addition to the plain "error" string already present.
+func errAttrs(err error, attrs []any) []any {
+	if a, ok := stackAttr(err); ok {
+		attrs = append(attrs, a)
+	}
+
+	if a, ok := errorChainAttr(err); ok {
+		attrs = append(attrs, a)
+	}
+
+	return attrs
+}
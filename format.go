@@ -0,0 +1,32 @@
+package slogtool
+
+// Format selects the handler used to render log records.
+type Format string
+
+const (
+	// FormatText renders records as slog's default key=value text.
+	FormatText Format = "text"
+	// FormatTint renders records as colorized, human-readable text via tint.
+	FormatTint Format = "tint"
+	// FormatJSON renders records as JSON.
+	FormatJSON Format = "json"
+)
+
+// ParseFormat converts a format string into a Format, defaulting to
+// FormatJSON when the string is unrecognized.
+func ParseFormat(format string) Format {
+	switch format {
+	case FormatText.String():
+		return FormatText
+	case FormatTint.String():
+		return FormatTint
+	case FormatJSON.String():
+		return FormatJSON
+	default:
+		return FormatJSON
+	}
+}
+
+func (f Format) String() string {
+	return string(f)
+}
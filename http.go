@@ -0,0 +1,42 @@
+package slogtool
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// levelPayload is the JSON body read and written by LevelHTTPHandler.
+type levelPayload struct {
+	Level string `json:"level"`
+}
+
+// LevelHTTPHandler returns an http.Handler that exposes l's level over HTTP:
+// GET returns the current level as {"level":"..."}, and PUT or POST with the
+// same body shape sets it, letting operators flip verbosity on a running
+// service without a restart.
+func LevelHTTPHandler(l *Slogger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			writeLevel(w, l.Level())
+		case http.MethodPut, http.MethodPost:
+			var payload levelPayload
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+
+				return
+			}
+
+			l.SetLevel(ParseLevel(payload.Level))
+			writeLevel(w, l.Level())
+		default:
+			w.Header().Set("Allow", "GET, PUT, POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func writeLevel(w http.ResponseWriter, level LogLevel) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(levelPayload{Level: level.String()})
+}
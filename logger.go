@@ -1,14 +1,10 @@
 package slogtool
 
 import (
-	"fmt"
-	"io"
+	"context"
 	"log/slog"
-	"os"
-	"runtime/debug"
+	"runtime"
 	"time"
-
-	"github.com/lmittmann/tint"
 )
 
 // LogLevel represents the severity of the log message.
@@ -19,14 +15,76 @@ const (
 	LevelInfo
 	LevelWarn
 	LevelError
+	LevelDisabled
 )
 
+// String returns the lowercase name of the level, as accepted by ParseLevel.
+func (l LogLevel) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelWarn:
+		return "warn"
+	case LevelError:
+		return "error"
+	case LevelDisabled:
+		return "disabled"
+	default:
+		return "info"
+	}
+}
+
+// ParseLevel converts a level string into a LogLevel, defaulting to
+// LevelInfo when the string is unrecognized.
+func ParseLevel(level string) LogLevel {
+	switch level {
+	case LevelDebug.String():
+		return LevelDebug
+	case LevelInfo.String():
+		return LevelInfo
+	case LevelWarn.String():
+		return LevelWarn
+	case LevelError.String():
+		return LevelError
+	case LevelDisabled.String():
+		return LevelDisabled
+	default:
+		return LevelInfo
+	}
+}
+
+// toSlogLevel converts a LogLevel into the slog.Level it should filter at.
+// LevelDisabled maps to a level above slog's highest built-in level so that
+// no record is ever emitted.
+func toSlogLevel(level LogLevel) slog.Level {
+	switch level {
+	case LevelDebug:
+		return slog.LevelDebug
+	case LevelInfo:
+		return slog.LevelInfo
+	case LevelWarn:
+		return slog.LevelWarn
+	case LevelError:
+		return slog.LevelError
+	case LevelDisabled:
+		return slog.LevelError + 4
+	default:
+		return slog.LevelInfo
+	}
+}
+
 // Logger interface defines methods for structured logging.
 type Logger interface {
 	Info(description string, attributes ...any)
 	Debug(description string, attributes ...any)
 	Error(description string, err error, attributes ...any)
 	Warn(description string, attributes ...any)
+	InfoContext(ctx context.Context, description string, attributes ...any)
+	DebugContext(ctx context.Context, description string, attributes ...any)
+	WarnContext(ctx context.Context, description string, attributes ...any)
+	ErrorContext(ctx context.Context, description string, err error, attributes ...any)
 	WithOperation(operation string) Logger
 	StringAttr(attribute string, value string) any
 	AnyAttr(attribute string, value any) any
@@ -37,10 +95,44 @@ type Logger interface {
 // Slogger is an implementation of Logger interface using slog.
 type Slogger struct {
 	logger *slog.Logger
+	level  *slog.LevelVar
+}
+
+// SetLevel adjusts the minimum level Slogger logs at, without re-initializing
+// the handler. Safe for concurrent use, including while other goroutines log.
+func (l *Slogger) SetLevel(level LogLevel) {
+	l.level.Set(toSlogLevel(level))
 }
 
+// Level returns the level Slogger currently logs at.
+func (l *Slogger) Level() LogLevel {
+	return fromSlogLevel(l.level.Level())
+}
+
+// fromSlogLevel converts a slog.Level back into the LogLevel it was built
+// from. Levels above slog.LevelError, such as the one toSlogLevel uses for
+// LevelDisabled, map back to LevelDisabled.
+func fromSlogLevel(level slog.Level) LogLevel {
+	switch {
+	case level <= slog.LevelDebug:
+		return LevelDebug
+	case level <= slog.LevelInfo:
+		return LevelInfo
+	case level <= slog.LevelWarn:
+		return LevelWarn
+	case level <= slog.LevelError:
+		return LevelError
+	default:
+		return LevelDisabled
+	}
+}
+
+// LogAndReturnError logs err at Error level and returns it unchanged, so
+// callers can write `return l.LogAndReturnError(...)`. It calls log directly
+// rather than Error so the caller PC it captures is this call site, not this
+// method's.
 func (l *Slogger) LogAndReturnError(message string, err error, attributes ...any) error {
-	l.Error(message, err, attributes...)
+	l.log(context.Background(), slog.LevelError, message, errorAttrs(err, attributes))
 
 	return err
 }
@@ -52,85 +144,107 @@ func (l *Slogger) LogAndReturnError(message string, err error, attributes ...any
 // log level to the respective standard output.
 //
 // If output is a file path, it configures the logger to log to that file.
+//
+// InitLogger is kept for backward compatibility; it preserves the original
+// behavior of rendering tint at LevelDebug and JSON at every other level. New
+// code should prefer NewFromConfig, which lets format and level vary independently.
 func InitLogger(mode LogLevel, output string) *Slogger {
-	options := &tint.Options{
-		Level:      slog.LevelDebug,
-		TimeFormat: time.Kitchen,
+	format := FormatJSON
+	if mode == LevelDebug {
+		format = FormatTint
 	}
 
-	var handler slog.Handler
+	logger, err := NewFromConfig(Config{Level: mode, Format: format, Output: output})
+	if err != nil {
+		panic(err)
+	}
 
-	switch output {
-	case "stdout":
-		handler = getHandlerForOutput(os.Stdout, mode, options)
-	case "stderr":
-		handler = getHandlerForOutput(os.Stderr, mode, options)
-	default:
-		file, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0755)
-		if err != nil {
-			panic(fmt.Sprintf("error opening log file %s: %s", output, err))
-		}
+	return logger
+}
 
-		handler = getHandlerForOutput(file, mode, options)
+// log builds and emits the record itself rather than delegating to
+// slog.Logger's own level methods, so the caller PC it embeds is the
+// application's call site instead of this package's. It must be called with
+// exactly one wrapper frame beneath it (e.g. Debug, InfoContext) for the
+// runtime.Callers skip count to land on the right frame.
+func (l *Slogger) log(ctx context.Context, level slog.Level, description string, attributes []any) {
+	if ctx == nil {
+		ctx = context.Background()
 	}
 
-	buildInfo, _ := debug.ReadBuildInfo()
-	if buildInfo == nil {
-		buildInfo = &debug.BuildInfo{GoVersion: "unknown"}
+	if !l.logger.Enabled(ctx, level) {
+		return
 	}
 
-	return &Slogger{
-		logger: slog.New(handler).With(
-			slog.Group("program_info",
-				slog.String("go_version", buildInfo.GoVersion),
-			),
-		),
-	}
-}
+	var pcs [1]uintptr
+	runtime.Callers(3, pcs[:]) // skip [Callers, log, Debug/Info/Warn/Error/*Context]
 
-// getHandlerForOutput returns an appropriate handler based on the log level and options.
-func getHandlerForOutput(output io.Writer, mode LogLevel, options *tint.Options) slog.Handler {
-	switch mode {
-	case LevelDebug:
-		return tint.NewHandler(output, options)
-	case LevelInfo:
-		return slog.NewJSONHandler(output, &slog.HandlerOptions{Level: slog.LevelInfo})
-	case LevelWarn:
-		return slog.NewJSONHandler(output, &slog.HandlerOptions{Level: slog.LevelWarn})
-	case LevelError:
-		return slog.NewJSONHandler(output, &slog.HandlerOptions{Level: slog.LevelError})
-	default:
-		return tint.NewHandler(output, options)
-	}
+	r := slog.NewRecord(time.Now(), level, description, pcs[0])
+	r.Add(attributes...)
+
+	_ = l.logger.Handler().Handle(ctx, r)
 }
 
 // Debug logs a debug level message.
 func (l *Slogger) Debug(description string, attributes ...any) {
-	l.logger.Debug(description, attributes...)
+	l.log(context.Background(), slog.LevelDebug, description, attributes)
 }
 
 // Info logs an info level message.
 func (l *Slogger) Info(description string, attributes ...any) {
-	l.logger.Info(description, attributes...)
+	l.log(context.Background(), slog.LevelInfo, description, attributes)
 }
 
-// Error logs an error level message with the error details.
+// Error logs an error level message with the error details. When err carries
+// a stack trace (e.g. from github.com/pkg/errors), it is attached as a
+// structured "stack" group attribute; when it wraps more than one error, they
+// are listed under "error_chain".
 func (l *Slogger) Error(description string, err error, attributes ...any) {
-	if err == nil {
-		attrs := append(attributes, slog.String("error", "nil"))
+	l.log(context.Background(), slog.LevelError, description, errorAttrs(err, attributes))
+}
+
+// Warn logs a warn level message.
+func (l *Slogger) Warn(description string, attributes ...any) {
+	l.log(context.Background(), slog.LevelWarn, description, attributes)
+}
+
+// InfoContext logs an info level message, forwarding ctx so handlers that
+// read trace IDs or deadlines from it (e.g. OTel bridges) can enrich the record.
+func (l *Slogger) InfoContext(ctx context.Context, description string, attributes ...any) {
+	l.log(ctx, slog.LevelInfo, description, attributes)
+}
 
-		l.logger.Error(description, attrs...)
+// DebugContext logs a debug level message, forwarding ctx so handlers that
+// read trace IDs or deadlines from it (e.g. OTel bridges) can enrich the record.
+func (l *Slogger) DebugContext(ctx context.Context, description string, attributes ...any) {
+	l.log(ctx, slog.LevelDebug, description, attributes)
+}
 
-		return
+// WarnContext logs a warn level message, forwarding ctx so handlers that
+// read trace IDs or deadlines from it (e.g. OTel bridges) can enrich the record.
+func (l *Slogger) WarnContext(ctx context.Context, description string, attributes ...any) {
+	l.log(ctx, slog.LevelWarn, description, attributes)
+}
+
+// ErrorContext logs an error level message with the error details, forwarding
+// ctx so handlers that read trace IDs or deadlines from it (e.g. OTel bridges)
+// can enrich the record. Stack traces and wrapped-error chains are attached
+// the same way as in Error.
+func (l *Slogger) ErrorContext(ctx context.Context, description string, err error, attributes ...any) {
+	l.log(ctx, slog.LevelError, description, errorAttrs(err, attributes))
+}
+
+// errorAttrs appends the "error" string attribute for err to attributes,
+// plus the structured stack/error_chain attributes when err has them. err
+// may be nil, in which case only {"error": "nil"} is added.
+func errorAttrs(err error, attributes []any) []any {
+	if err == nil {
+		return append(attributes, slog.String("error", "nil"))
 	}
 
 	attrs := append(attributes, slog.String("error", err.Error()))
-	l.logger.Error(description, attrs...)
-}
 
-// Warn logs a warn level message.
-func (l *Slogger) Warn(description string, attributes ...any) {
-	l.logger.Warn(description, attributes...)
+	return errAttrs(err, attrs)
 }
 
 // WithOperation returns a new logger with the given operation name.
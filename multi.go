@@ -0,0 +1,131 @@
+package slogtool
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"runtime/debug"
+)
+
+// Sink describes one destination for InitLoggerMulti: a writer with its own
+// level threshold and format, independent of every other sink.
+type Sink struct {
+	Writer io.Writer
+	Level  LogLevel
+	Format Format
+}
+
+// MultiHandler fans out each record to a set of child slog.Handlers, each
+// with its own level and format, so a single log call can reach many
+// destinations at once (e.g. colored tint to stderr at Debug, JSON to a file
+// at Info, JSON to an error-only file at Error).
+type MultiHandler struct {
+	handlers []slog.Handler
+}
+
+// NewMultiHandler returns a MultiHandler that fans out to the given handlers.
+func NewMultiHandler(handlers ...slog.Handler) *MultiHandler {
+	return &MultiHandler{handlers: handlers}
+}
+
+// Enabled reports whether any child handler is enabled for level.
+func (m *MultiHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, h := range m.handlers {
+		if h.Enabled(ctx, level) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Handle dispatches r to every child handler whose level permits it,
+// collecting any errors together.
+func (m *MultiHandler) Handle(ctx context.Context, r slog.Record) error {
+	var errs error
+
+	for _, h := range m.handlers {
+		if !h.Enabled(ctx, r.Level) {
+			continue
+		}
+
+		if err := h.Handle(ctx, r.Clone()); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// WithAttrs returns a MultiHandler whose children are each the result of
+// calling WithAttrs on the corresponding child of m.
+func (m *MultiHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clones := make([]slog.Handler, len(m.handlers))
+	for i, h := range m.handlers {
+		clones[i] = h.WithAttrs(attrs)
+	}
+
+	return &MultiHandler{handlers: clones}
+}
+
+// WithGroup returns a MultiHandler whose children are each the result of
+// calling WithGroup on the corresponding child of m.
+func (m *MultiHandler) WithGroup(name string) slog.Handler {
+	clones := make([]slog.Handler, len(m.handlers))
+	for i, h := range m.handlers {
+		clones[i] = h.WithGroup(name)
+	}
+
+	return &MultiHandler{handlers: clones}
+}
+
+// flooredLeveler reports the greater of a fixed, per-sink configured level
+// and a shared floor, so raising the floor can only make a sink stricter
+// (more filtered) than it already was, never more permissive, and never
+// silences a sink that is already at or above the floor.
+type flooredLeveler struct {
+	floor      *slog.LevelVar
+	configured slog.Level
+}
+
+func (f *flooredLeveler) Level() slog.Level {
+	if floor := f.floor.Level(); floor > f.configured {
+		return floor
+	}
+
+	return f.configured
+}
+
+// InitLoggerMulti initializes a Slogger that fans every record out to each
+// of the given sinks, formatted independently per sink. Sink.Level sets each
+// sink's own threshold; SetLevel raises or lowers a shared floor that every
+// sink's effective threshold is clamped to from below, via
+// max(sink's configured level, floor), so raising the floor can only
+// silence sinks already more permissive than it, never one already at or
+// above it. Level reports the floor most recently passed to SetLevel (or,
+// initially, slog.LevelDebug, which floors nothing).
+func InitLoggerMulti(sinks ...Sink) *Slogger {
+	floor := &slog.LevelVar{}
+	floor.Set(slog.LevelDebug)
+
+	handlers := make([]slog.Handler, 0, len(sinks))
+	for _, sink := range sinks {
+		leveler := &flooredLeveler{floor: floor, configured: toSlogLevel(sink.Level)}
+		handlers = append(handlers, buildHandler(sink.Writer, Config{Level: sink.Level, Format: sink.Format}, leveler))
+	}
+
+	buildInfo, _ := debug.ReadBuildInfo()
+	if buildInfo == nil {
+		buildInfo = &debug.BuildInfo{GoVersion: "unknown"}
+	}
+
+	return &Slogger{
+		logger: slog.New(NewMultiHandler(handlers...)).With(
+			slog.Group("program_info",
+				slog.String("go_version", buildInfo.GoVersion),
+			),
+		),
+		level: floor,
+	}
+}
@@ -0,0 +1,40 @@
+package slogtool
+
+import "context"
+
+// noop is a Logger that discards everything it is given. It is returned by
+// FromContext when the context carries no logger, so callers never need to
+// nil-check the result.
+var noop Logger = noopLogger{}
+
+type noopLogger struct{}
+
+func (noopLogger) Info(description string, attributes ...any)             {}
+func (noopLogger) Debug(description string, attributes ...any)            {}
+func (noopLogger) Warn(description string, attributes ...any)             {}
+func (noopLogger) Error(description string, err error, attributes ...any) {}
+
+func (noopLogger) InfoContext(ctx context.Context, description string, attributes ...any)             {}
+func (noopLogger) DebugContext(ctx context.Context, description string, attributes ...any)            {}
+func (noopLogger) WarnContext(ctx context.Context, description string, attributes ...any)             {}
+func (noopLogger) ErrorContext(ctx context.Context, description string, err error, attributes ...any) {}
+
+func (n noopLogger) WithOperation(operation string) Logger {
+	return n
+}
+
+func (n noopLogger) With(attributes ...any) Logger {
+	return n
+}
+
+func (noopLogger) StringAttr(attribute string, value string) any {
+	return value
+}
+
+func (noopLogger) AnyAttr(attribute string, value any) any {
+	return value
+}
+
+func (noopLogger) LogAndReturnError(message string, err error, attributes ...any) error {
+	return err
+}
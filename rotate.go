@@ -0,0 +1,196 @@
+package slogtool
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// RotateOpts configures size- and time-based rotation for a RotatingWriter.
+type RotateOpts struct {
+	// MaxSizeMB rotates the file once it would exceed this size, in megabytes.
+	// Zero disables size-based rotation.
+	MaxSizeMB int
+	// MaxAgeDays rotates the file once it is this many days old, counted from
+	// when it was opened or, for a file that already had content when opened,
+	// from its last modification time. Zero disables time-based rotation.
+	MaxAgeDays int
+	// MaxBackups is the number of rotated files to keep; the oldest beyond
+	// this count are deleted. Zero keeps every rotated file.
+	MaxBackups int
+	// Compress gzips rotated files and removes the uncompressed copy.
+	Compress bool
+}
+
+// RotatingWriter is an io.Writer over a file at a fixed path that rolls the
+// file over to a timestamped backup once it exceeds RotateOpts.MaxSizeMB or
+// reaches the age RotateOpts.MaxAgeDays, pruning old backups and
+// optionally gzipping them.
+type RotatingWriter struct {
+	path string
+	opts RotateOpts
+
+	mu       sync.Mutex
+	file     *os.File
+	size     int64
+	openedAt time.Time
+}
+
+// RotatingFile returns a RotatingWriter for path, usable as Config.Output:
+//
+//	Config.Output = slogtool.RotatingFile("/var/log/app.log", slogtool.RotateOpts{MaxSizeMB: 100, MaxBackups: 7, Compress: true})
+func RotatingFile(path string, opts RotateOpts) *RotatingWriter {
+	return &RotatingWriter{path: path, opts: opts}
+}
+
+// Write implements io.Writer, rotating the underlying file first if needed.
+func (w *RotatingWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err := w.ensureOpen(); err != nil {
+		return 0, err
+	}
+
+	if w.shouldRotate(len(p)) {
+		if err := w.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+
+	return n, err
+}
+
+func (w *RotatingWriter) ensureOpen() error {
+	if w.file != nil {
+		return nil
+	}
+
+	file, err := os.OpenFile(w.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0755)
+	if err != nil {
+		return fmt.Errorf("error opening log file %s: %w", w.path, err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("error stating log file %s: %w", w.path, err)
+	}
+
+	w.file = file
+	w.size = info.Size()
+
+	if info.Size() > 0 {
+		// The file already had content, e.g. from before a process restart:
+		// its age is how long ago it was last written, not now.
+		w.openedAt = info.ModTime()
+	} else {
+		w.openedAt = time.Now()
+	}
+
+	return nil
+}
+
+func (w *RotatingWriter) shouldRotate(incoming int) bool {
+	if w.opts.MaxSizeMB > 0 {
+		maxSize := int64(w.opts.MaxSizeMB) * 1024 * 1024
+		if w.size+int64(incoming) > maxSize {
+			return true
+		}
+	}
+
+	if w.opts.MaxAgeDays > 0 && time.Since(w.openedAt) >= time.Duration(w.opts.MaxAgeDays)*24*time.Hour {
+		return true
+	}
+
+	return false
+}
+
+// rotate closes the current file and moves it to a timestamped backup. On
+// any failure after the close, it leaves w.file nil rather than pointing at
+// the now-closed descriptor, so the next ensureOpen call can recover instead
+// of every subsequent Write failing for the rest of the process's life.
+func (w *RotatingWriter) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return fmt.Errorf("error closing log file %s: %w", w.path, err)
+	}
+
+	w.file = nil
+
+	backup := fmt.Sprintf("%s.%s", w.path, time.Now().Format("20060102T150405.000"))
+	if err := os.Rename(w.path, backup); err != nil {
+		return fmt.Errorf("error rotating log file %s: %w", w.path, err)
+	}
+
+	if w.opts.Compress {
+		if err := compressFile(backup); err != nil {
+			return fmt.Errorf("error compressing rotated log file %s: %w", backup, err)
+		}
+	}
+
+	if err := w.pruneBackups(); err != nil {
+		return fmt.Errorf("error pruning rotated log files for %s: %w", w.path, err)
+	}
+
+	return w.ensureOpen()
+}
+
+// compressFile gzips path to path+".gz" and removes the uncompressed original.
+func compressFile(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(path+".gz", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0755)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	gz := gzip.NewWriter(dst)
+
+	if _, err := io.Copy(gz, src); err != nil {
+		return err
+	}
+
+	if err := gz.Close(); err != nil {
+		return err
+	}
+
+	return os.Remove(path)
+}
+
+// pruneBackups deletes the oldest rotated files for w.path beyond MaxBackups.
+func (w *RotatingWriter) pruneBackups() error {
+	if w.opts.MaxBackups <= 0 {
+		return nil
+	}
+
+	matches, err := filepath.Glob(w.path + ".*")
+	if err != nil {
+		return err
+	}
+
+	if len(matches) <= w.opts.MaxBackups {
+		return nil
+	}
+
+	sort.Strings(matches)
+
+	for _, stale := range matches[:len(matches)-w.opts.MaxBackups] {
+		if err := os.Remove(stale); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}